@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	kitconsul "github.com/go-kit/kit/sd/consul"
+	consulapi "github.com/hashicorp/consul/api"
+)
+
+// serviceName is the name stringsvc instances register themselves under
+// in Consul, and the name peers look each other up by.
+const serviceName = "stringsvc"
+
+// registerConsul registers this instance in Consul under serviceName,
+// wired to an HTTP health check at advertiseAddr:port/health. It returns
+// an sd.Instancer for discovering peers, and a deregister func to call on
+// shutdown.
+func registerConsul(consulAddr, advertiseAddr string, port int, logger kitlog.Logger) (sd.Instancer, func(), error) {
+	consulConfig := consulapi.DefaultConfig()
+	consulConfig.Address = consulAddr
+	client, err := consulapi.NewClient(consulConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	sdClient := kitconsul.NewClient(client)
+
+	registration := &consulapi.AgentServiceRegistration{
+		ID:      fmt.Sprintf("%s-%s-%d", serviceName, advertiseAddr, port),
+		Name:    serviceName,
+		Address: advertiseAddr,
+		Port:    port,
+		Check: &consulapi.AgentServiceCheck{
+			HTTP:     fmt.Sprintf("http://%s:%d/health", advertiseAddr, port),
+			Interval: "10s",
+			Timeout:  "1s",
+		},
+	}
+	registrar := kitconsul.NewRegistrar(sdClient, registration, logger)
+	registrar.Register()
+
+	instancer := kitconsul.NewInstancer(sdClient, logger, serviceName, []string{}, true)
+
+	return instancer, registrar.Deregister, nil
+}
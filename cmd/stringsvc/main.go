@@ -0,0 +1,146 @@
+// Command stringsvc runs the string service, serving JSON-over-HTTP on
+// :8080 and gRPC on :8081 concurrently.
+// ===============================================================
+// EXAMPLE USAGE
+// ===============================================================
+// $ curl -XPOST -d'{"s":"hello, world"}' localhost:8080/uppercase
+// {"v":"HELLO, WORLD"}
+// $ curl -XPOST -d'{"s":"hello, world"}' localhost:8080/count
+// {"v":12}
+// ===============================================================
+package main
+
+import (
+	"context"
+	"flag"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics/prometheus"
+	"google.golang.org/grpc"
+
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/jaggedprospect/gokit-stringsvc/pb"
+	"github.com/jaggedprospect/gokit-stringsvc/stringsvc"
+	grpcTransport "github.com/jaggedprospect/gokit-stringsvc/transport/grpc"
+)
+
+// gracefulShutdownTimeout bounds how long in-flight requests get to drain
+// before both servers are forced to stop.
+const gracefulShutdownTimeout = 10 * time.Second
+
+func main() {
+	var (
+		qps                 = flag.Float64("qps", 100, "per-endpoint rate limit, in requests per second")
+		burst               = flag.Int("burst", 100, "per-endpoint rate limiter burst size")
+		breakerTimeout      = flag.Duration("breaker-timeout", 10*time.Second, "how long the circuit breaker stays open before retrying")
+		breakerFailureRatio = flag.Float64("breaker-failure-ratio", 0.5, "fraction of requests that must fail before the breaker trips open")
+
+		consulAddr      = flag.String("consul-addr", "", "Consul agent address; if set, this instance registers itself and proxies to peers")
+		advertiseAddr   = flag.String("advertise-addr", "127.0.0.1", "address peers and Consul's health check should use to reach this instance")
+		proxyFraction   = flag.Float64("proxy-fraction", 0.1, "fraction of Uppercase calls to forward to peer instances")
+		proxyMaxRetries = flag.Int("proxy-max-retries", 3, "max peers to try per proxied call")
+		proxyTimeout    = flag.Duration("proxy-timeout", time.Second, "total time budget for a proxied call across all retries")
+	)
+	flag.Parse()
+
+	resilience := stringsvc.ResilienceConfig{
+		QPS:            *qps,
+		Burst:          *burst,
+		BreakerTimeout: *breakerTimeout,
+		FailureRatio:   *breakerFailureRatio,
+	}
+
+	logger := kitlog.NewLogfmtLogger(os.Stderr)
+
+	fieldKeys := []string{"method", "error"}
+	requestCount := prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "stringsvc",
+		Subsystem: "string_service",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, fieldKeys)
+	requestLatency := prometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+		Namespace: "stringsvc",
+		Subsystem: "string_service",
+		Name:      "request_latency_seconds",
+		Help:      "Total duration of requests in seconds.",
+	}, fieldKeys)
+
+	var svc stringsvc.StringService = stringsvc.New()
+
+	var deregister func()
+	if *consulAddr != "" {
+		instancer, dereg, err := registerConsul(*consulAddr, *advertiseAddr, 8080, logger)
+		if err != nil {
+			logger.Log("during", "registerConsul", "err", err)
+			os.Exit(1)
+		}
+		deregister = dereg
+		svc = stringsvc.NewProxyingMiddleware(instancer, stringsvc.ProxyConfig{
+			Fraction:    *proxyFraction,
+			MaxAttempts: *proxyMaxRetries,
+			Timeout:     *proxyTimeout,
+		}, logger)(svc)
+	}
+
+	svc = stringsvc.NewLoggingMiddleware(logger)(svc)
+	svc = stringsvc.NewInstrumentingMiddleware(requestCount, requestLatency)(svc)
+
+	endpoints := stringsvc.NewSet(svc, resilience)
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/", stringsvc.NewHTTPHandler(endpoints))
+	httpMux.Handle("/health", stringsvc.NewHealthCheckHandler())
+	httpMux.Handle("/metrics", promhttp.Handler())
+	httpMux.Handle("/transform", stringsvc.NewTransformHTTPHandler(svc))
+	httpMux.Handle("/ws/transform", stringsvc.NewTransformWSHandler(svc))
+
+	httpServer := &http.Server{Addr: ":8080", Handler: httpMux}
+
+	grpcListener, err := net.Listen("tcp", ":8081")
+	if err != nil {
+		logger.Log("transport", "gRPC", "during", "Listen", "err", err)
+		os.Exit(1)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterStringServiceServer(grpcServer, grpcTransport.NewGRPCServer(endpoints))
+
+	errc := make(chan error, 2)
+	go func() {
+		logger.Log("transport", "HTTP", "addr", ":8080")
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errc <- err
+		}
+	}()
+	go func() {
+		logger.Log("transport", "gRPC", "addr", ":8081")
+		errc <- grpcServer.Serve(grpcListener)
+	}()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err := <-errc:
+		logger.Log("err", err)
+	case s := <-sig:
+		logger.Log("signal", s)
+	}
+
+	if deregister != nil {
+		deregister()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+	defer cancel()
+	httpServer.Shutdown(ctx)
+	grpcServer.GracefulStop()
+}
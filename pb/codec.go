@@ -0,0 +1,35 @@
+package pb
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// jsonCodec is a grpc/encoding.Codec that marshals messages as JSON
+// instead of protobuf wire format. It's registered under the "proto"
+// content-subtype, which is what grpc uses by default when a call
+// doesn't request a content-subtype of its own, so every StringService
+// RPC goes over this codec without any client-side opt-in.
+//
+// This only exists because the message types in this package are
+// hand-written, not protoc-generated, and so don't implement
+// proto.Message. Delete this file along with the codegen TODO in
+// stringsvc.pb.go once real protobuf codegen is wired up.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return "proto"
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
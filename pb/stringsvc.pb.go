@@ -0,0 +1,129 @@
+// Package pb defines the StringService gRPC service.
+//
+// These message and service types would normally come from
+// `protoc --go_out --go-grpc_out stringsvc.proto`, but protoc isn't
+// available in this build environment, so they're hand-written instead.
+// Because they don't implement proto.Message, they can't go over the
+// wire with gRPC's default protobuf codec; codec.go registers a JSON
+// codec under the "proto" content-subtype so real gRPC calls still work
+// end to end. Swap this file for real protoc-gen-go/protoc-gen-go-grpc
+// output (and delete codec.go) once protoc is available.
+package pb
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+type UppercaseRequest struct {
+	S string `json:"s"`
+}
+
+type UppercaseReply struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"`
+}
+
+type CountRequest struct {
+	S string `json:"s"`
+}
+
+type CountReply struct {
+	V int64 `json:"v"`
+}
+
+// StringServiceClient is the client API for StringService service.
+type StringServiceClient interface {
+	Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseReply, error)
+	Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountReply, error)
+}
+
+type stringServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStringServiceClient(cc *grpc.ClientConn) StringServiceClient {
+	return &stringServiceClient{cc}
+}
+
+func (c *stringServiceClient) Uppercase(ctx context.Context, in *UppercaseRequest, opts ...grpc.CallOption) (*UppercaseReply, error) {
+	out := new(UppercaseReply)
+	if err := c.cc.Invoke(ctx, "/pb.StringService/Uppercase", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stringServiceClient) Count(ctx context.Context, in *CountRequest, opts ...grpc.CallOption) (*CountReply, error) {
+	out := new(CountReply)
+	if err := c.cc.Invoke(ctx, "/pb.StringService/Count", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StringServiceServer is the server API for StringService service.
+type StringServiceServer interface {
+	Uppercase(context.Context, *UppercaseRequest) (*UppercaseReply, error)
+	Count(context.Context, *CountRequest) (*CountReply, error)
+}
+
+// UnimplementedStringServiceServer may be embedded to have forward
+// compatible implementations.
+type UnimplementedStringServiceServer struct{}
+
+func (UnimplementedStringServiceServer) Uppercase(context.Context, *UppercaseRequest) (*UppercaseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Uppercase not implemented")
+}
+
+func (UnimplementedStringServiceServer) Count(context.Context, *CountRequest) (*CountReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+
+func RegisterStringServiceServer(s *grpc.Server, srv StringServiceServer) {
+	s.RegisterService(&_StringService_serviceDesc, srv)
+}
+
+func _StringService_Uppercase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UppercaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Uppercase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/pb.StringService/Uppercase"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Uppercase(ctx, req.(*UppercaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StringService_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StringServiceServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/pb.StringService/Count"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StringServiceServer).Count(ctx, req.(*CountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StringService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.StringService",
+	HandlerType: (*StringServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Uppercase", Handler: _StringService_Uppercase_Handler},
+		{MethodName: "Count", Handler: _StringService_Count_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stringsvc.proto",
+}
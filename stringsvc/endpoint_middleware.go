@@ -0,0 +1,89 @@
+package stringsvc
+
+// ============ ENDPOINT MIDDLEWARES ============
+// >>> Unlike a ServiceMiddleware, an endpoint.Middleware wraps a single
+// endpoint.Endpoint. This file applies rate limiting and circuit breaking
+// around the Uppercase and Count endpoints so a misbehaving caller or a
+// failing dependency can't take the whole service down with it.
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/ratelimit"
+	"github.com/sony/gobreaker"
+	"golang.org/x/time/rate"
+)
+
+// failer is implemented by response types (like UppercaseResponse) that
+// carry a business-logic error inline instead of returning it as the
+// endpoint's Go error. The breaker needs to see these as failures even
+// though the endpoint itself reports them as a successful call.
+type failer interface {
+	Failed() error
+}
+
+// ResilienceConfig tunes the rate limiter and circuit breaker wrapped
+// around each endpoint in a Set. It's meant to be populated from CLI
+// flags so operators can retune resilience without a code change.
+type ResilienceConfig struct {
+	// QPS and Burst configure the token-bucket rate limiter.
+	QPS   float64
+	Burst int
+
+	// BreakerTimeout is how long the breaker stays open before allowing a
+	// trial request through again.
+	BreakerTimeout time.Duration
+	// FailureRatio is the fraction of requests (out of a minimum sample)
+	// that must fail before the breaker trips open.
+	FailureRatio float64
+}
+
+// applyResilience wraps ep with a rate limiter and a circuit breaker
+// configured from cfg. name identifies the endpoint in breaker metrics.
+func applyResilience(name string, ep endpoint.Endpoint, cfg ResilienceConfig) endpoint.Endpoint {
+	limiter := rate.NewLimiter(rate.Limit(cfg.QPS), cfg.Burst)
+	ep = ratelimit.NewErroringLimiter(limiter)(ep)
+
+	breaker := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    name,
+		Timeout: cfg.BreakerTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			failureRatio := float64(counts.TotalFailures) / float64(counts.Requests)
+			return counts.Requests >= 3 && failureRatio >= cfg.FailureRatio
+		},
+	})
+	ep = gobreakerMiddleware(breaker)(ep)
+
+	return ep
+}
+
+// gobreakerMiddleware is go-kit's circuitbreaker.Gobreaker, with one
+// addition: a business-logic error carried inline on a failer response
+// (e.g. UppercaseResponse.Err) counts as a breaker failure too, not just a
+// Go error returned by the endpoint. Call sites still see the original
+// response and a nil error for those business failures; any other error —
+// the breaker tripping open, or a real error from next (e.g. a rate
+// limiter rejecting the request) — is propagated unchanged.
+func gobreakerMiddleware(breaker *gobreaker.CircuitBreaker) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			var businessErr error
+			response, err := breaker.Execute(func() (interface{}, error) {
+				response, err := next(ctx, request)
+				if err != nil {
+					return response, err
+				}
+				if f, ok := response.(failer); ok {
+					businessErr = f.Failed()
+				}
+				return response, businessErr
+			})
+			if err != nil && err != businessErr {
+				return nil, err
+			}
+			return response, nil
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package stringsvc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/ratelimit"
+)
+
+// faultyService always fails, so the breaker has something to trip on.
+type faultyService struct{}
+
+func (faultyService) Uppercase(context.Context, string) (string, error) {
+	return "", errors.New("boom")
+}
+
+func (faultyService) Count(context.Context, string) int {
+	return 0
+}
+
+func (faultyService) TransformStream(context.Context, []string, io.Reader, io.Writer) error {
+	return errors.New("boom")
+}
+
+func TestCircuitBreakerOpensAfterConsecutiveErrors(t *testing.T) {
+	cfg := ResilienceConfig{
+		QPS:            1000, // rate limiting isn't under test here
+		Burst:          1000,
+		BreakerTimeout: time.Minute,
+		FailureRatio:   0.5,
+	}
+	set := NewSet(faultyService{}, cfg)
+
+	var lastErr error
+	for i := 0; i < 5; i++ {
+		resp, err := set.UppercaseEndpoint(context.Background(), UppercaseRequest{S: "x"})
+		lastErr = err
+		if err == nil {
+			if response := resp.(UppercaseResponse); response.Err == "" {
+				t.Fatalf("call %d: expected a business error from the faulty service, got none", i)
+			}
+		}
+	}
+
+	if lastErr == nil {
+		t.Fatalf("after repeated failures: got nil error, want breaker to be open")
+	}
+	if got, want := lastErr.Error(), "circuit breaker is open"; got != want {
+		t.Fatalf("after repeated failures: got error %q, want breaker to be open (%q)", got, want)
+	}
+}
+
+// TestRateLimitErrorPropagatesThroughBreaker guards against a regression
+// where gobreakerMiddleware swallowed every non-breaker error coming out
+// of next, not just the business error it converts from a failer
+// response. A real error from deeper in the chain — here, the rate
+// limiter wrapped just inside the breaker — must still reach the caller,
+// not come back as (nil, nil).
+func TestRateLimitErrorPropagatesThroughBreaker(t *testing.T) {
+	cfg := ResilienceConfig{
+		QPS:            0, // no tokens, so every request is rejected
+		Burst:          0,
+		BreakerTimeout: time.Minute,
+		FailureRatio:   0.5,
+	}
+	set := NewSet(stringService{}, cfg)
+
+	resp, err := set.UppercaseEndpoint(context.Background(), UppercaseRequest{S: "x"})
+	if err == nil {
+		t.Fatalf("expected the rate limiter's rejection to propagate, got response %v with nil error", resp)
+	}
+	if !errors.Is(err, ratelimit.ErrLimited) {
+		t.Fatalf("got error %v, want %v", err, ratelimit.ErrLimited)
+	}
+	if resp != nil {
+		t.Fatalf("expected a nil response alongside the error, got %v", resp)
+	}
+}
+
+// TestCountDegradesGracefullyWhenRejected guards against the same class
+// of bug: Set.Count has no error in its signature, so when the rate
+// limiter rejects a call and CountEndpoint returns (nil, err), Count must
+// not type-assert that nil response straight into a panic.
+func TestCountDegradesGracefullyWhenRejected(t *testing.T) {
+	cfg := ResilienceConfig{
+		QPS:            0,
+		Burst:          0,
+		BreakerTimeout: time.Minute,
+		FailureRatio:   0.5,
+	}
+	set := NewSet(stringService{}, cfg)
+
+	if got := set.Count(context.Background(), "hello"); got != 0 {
+		t.Fatalf("got Count() = %d, want 0 for a rejected call", got)
+	}
+}
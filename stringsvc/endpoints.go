@@ -0,0 +1,110 @@
+package stringsvc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kit/kit/endpoint"
+)
+
+// Set collects all of the endpoints that compose the StringService. It's
+// meant to be used as a helper struct, to collect all the endpoints into a
+// single parameter so transports (HTTP, gRPC) can share them.
+type Set struct {
+	UppercaseEndpoint endpoint.Endpoint
+	CountEndpoint     endpoint.Endpoint
+}
+
+// NewSet returns a Set that wraps the provided service, with rate
+// limiting and circuit breaking applied to each endpoint per cfg.
+func NewSet(svc StringService, cfg ResilienceConfig) Set {
+	return Set{
+		UppercaseEndpoint: applyResilience("uppercase", makeUppercaseEndpoint(svc), cfg),
+		CountEndpoint:     applyResilience("count", makeCountEndpoint(svc), cfg),
+	}
+}
+
+// Uppercase implements StringService, calling the endpoint directly. It
+// allows Set to be used as a StringService by gRPC and other clients.
+func (s Set) Uppercase(ctx context.Context, str string) (string, error) {
+	resp, err := s.UppercaseEndpoint(ctx, UppercaseRequest{S: str})
+	if err != nil {
+		return "", err
+	}
+	response := resp.(UppercaseResponse)
+	if response.Err != "" {
+		return response.V, errors.New(response.Err)
+	}
+	return response.V, nil
+}
+
+// Count implements StringService, calling the endpoint directly. Count
+// has no error in its signature, so a rejected or circuit-broken call
+// degrades to 0 rather than a panic on the nil response.
+func (s Set) Count(ctx context.Context, str string) int {
+	resp, err := s.CountEndpoint(ctx, CountRequest{S: str})
+	if err != nil {
+		return 0
+	}
+	response := resp.(CountResponse)
+	return response.V
+}
+
+// ============ REQUESTS / RESPONSES ============
+// >>> The primary messaging parttern is RPC (remote procedure call).
+// For each method, request and response structs are defined which
+// capture all of the input and output parameters. These are transport
+// agnostic; HTTP and gRPC each bring their own encoders/decoders.
+
+type UppercaseRequest struct {
+	S string `json:"s"`
+}
+
+type UppercaseResponse struct {
+	V   string `json:"v"`
+	Err string `json:"err,omitempty"` // errors don't JSON-marshal, so we use a string
+}
+
+// Failed implements the failer interface consumed by gobreakerMiddleware,
+// so a business-logic error reported inline still counts as a failure for
+// circuit-breaking purposes.
+func (r UppercaseResponse) Failed() error {
+	if r.Err != "" {
+		return errors.New(r.Err)
+	}
+	return nil
+}
+
+type CountRequest struct {
+	S string `json:"s"`
+}
+
+type CountResponse struct {
+	V int `json:"v"`
+}
+
+// ============ ENDPOINTS ============
+// >>> Go kit provides most functionality through an abstraction called
+// an ENDPOINT. It represents a single RPC (i.e. a single method in the
+// service interface). ADAPTERS convert each service method into an
+// endpoint. Each ADAPTER takes a StringService and returns an ENDPOINT
+// that corresponds to one of the methods.
+
+func makeUppercaseEndpoint(svc StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(UppercaseRequest)
+		v, err := svc.Uppercase(ctx, req.S)
+		if err != nil {
+			return UppercaseResponse{v, err.Error()}, nil
+		}
+		return UppercaseResponse{v, ""}, nil
+	}
+}
+
+func makeCountEndpoint(svc StringService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(CountRequest)
+		v := svc.Count(ctx, req.S)
+		return CountResponse{v}, nil
+	}
+}
@@ -0,0 +1,13 @@
+package stringsvc
+
+import "net/http"
+
+// NewHealthCheckHandler returns a handler that always reports 200 OK. It's
+// used both by operators and by Consul's HTTP health check when the
+// service registers itself for discovery.
+func NewHealthCheckHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("OK"))
+	})
+}
@@ -0,0 +1,98 @@
+package stringsvc
+
+// ============ PROXYING ============
+// >>> proxymw forwards a fraction of Uppercase calls to peer stringsvc
+// instances discovered through Consul, instead of always serving them
+// locally. This is what lets an operator run several stringsvc nodes and
+// get client-side load balancing across them, from within the service
+// itself.
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/url"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	"github.com/go-kit/kit/sd/lb"
+	httpTransport "github.com/go-kit/kit/transport/http"
+)
+
+// ProxyConfig configures how a fraction of Uppercase calls are forwarded
+// to peer instances rather than served locally.
+type ProxyConfig struct {
+	// Fraction is the probability, in [0,1], that a given Uppercase call
+	// is proxied to a peer. 0 disables proxying entirely.
+	Fraction float64
+	// MaxAttempts bounds how many peers lb.Retry will try per call.
+	MaxAttempts int
+	// Timeout bounds how long lb.Retry waits across all attempts.
+	Timeout time.Duration
+}
+
+// NewProxyingMiddleware returns a ServiceMiddleware that forwards a
+// fraction of Uppercase calls to peer instances discovered through
+// instancer, load balanced round-robin with retries. Count is never
+// proxied; it's cheap enough to always serve locally.
+func NewProxyingMiddleware(instancer sd.Instancer, cfg ProxyConfig, logger kitlog.Logger) ServiceMiddleware {
+	return func(next StringService) StringService {
+		endpointer := sd.NewEndpointer(instancer, uppercaseFactory, logger)
+		balancer := lb.NewRoundRobin(endpointer)
+		retry := lb.Retry(cfg.MaxAttempts, cfg.Timeout, balancer)
+		return proxymw{next: next, proxy: retry, fraction: cfg.Fraction}
+	}
+}
+
+// proxymw implements StringService, proxying a fraction of Uppercase calls
+// and always falling back to next on error so a flaky peer never takes the
+// whole request down.
+type proxymw struct {
+	next     StringService
+	proxy    endpoint.Endpoint
+	fraction float64
+}
+
+func (mw proxymw) Uppercase(ctx context.Context, s string) (string, error) {
+	if mw.fraction <= 0 || rand.Float64() >= mw.fraction {
+		return mw.next.Uppercase(ctx, s)
+	}
+
+	response, err := mw.proxy(ctx, UppercaseRequest{S: s})
+	if err != nil {
+		return mw.next.Uppercase(ctx, s)
+	}
+	resp := response.(UppercaseResponse)
+	if resp.Err != "" {
+		return resp.V, errors.New(resp.Err)
+	}
+	return resp.V, nil
+}
+
+func (mw proxymw) Count(ctx context.Context, s string) int {
+	return mw.next.Count(ctx, s)
+}
+
+// TransformStream is never proxied; it's always served locally.
+func (mw proxymw) TransformStream(ctx context.Context, ops []string, r io.Reader, w io.Writer) error {
+	return mw.next.TransformStream(ctx, ops, r, w)
+}
+
+// uppercaseFactory adapts a peer instance (host:port) into an
+// endpoint.Endpoint that calls that peer's HTTP /uppercase handler.
+func uppercaseFactory(instance string) (endpoint.Endpoint, io.Closer, error) {
+	u, err := url.Parse("http://" + instance + "/uppercase")
+	if err != nil {
+		return nil, nil, err
+	}
+	client := httpTransport.NewClient(
+		"POST",
+		u,
+		encodeUppercaseRequest,
+		decodeUppercaseResponse,
+	).Endpoint()
+	return client, nil, nil
+}
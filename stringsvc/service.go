@@ -0,0 +1,185 @@
+// Package stringsvc provides operations on strings, along with the
+// middlewares and endpoints used to expose it over HTTP and gRPC.
+package stringsvc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+)
+
+// StringService provides operations on strings.
+type StringService interface {
+	Uppercase(ctx context.Context, s string) (string, error)
+	Count(ctx context.Context, s string) int
+	// TransformStream applies ops, in order, to r line-by-line, writing
+	// each transformed line to w as soon as it's ready.
+	TransformStream(ctx context.Context, ops []string, r io.Reader, w io.Writer) error
+}
+
+type stringService struct{}
+
+// New returns a basic StringService with no middlewares wired in.
+func New() StringService {
+	return stringService{}
+}
+
+func (stringService) Uppercase(_ context.Context, s string) (string, error) {
+	if s == "" {
+		return "", ErrEmpty
+	}
+	return strings.ToUpper(s), nil
+}
+
+func (stringService) Count(_ context.Context, s string) int {
+	return len(s)
+}
+
+func (stringService) TransformStream(_ context.Context, ops []string, r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if _, err := io.WriteString(w, transformLine(ops, scanner.Text())+"\n"); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ErrEmpty is returned when input string is empty
+var ErrEmpty = errors.New("empty string")
+
+// ============ MIDDLEWARES ============
+// >>> A Go kit SERVICE MIDDLEWARE takes a StringService and returns another
+// one, wrapping it with cross-cutting behavior. Middlewares are composed
+// around the service by the caller, outermost first.
+
+// ServiceMiddleware describes a service (as opposed to endpoint) middleware.
+type ServiceMiddleware func(StringService) StringService
+
+// contextKey avoids collisions with other packages' context keys.
+type contextKey string
+
+// RequestIDKey is the context key under which the correlation ID extracted
+// from the inbound X-Request-ID header is stored.
+const RequestIDKey contextKey = "request-id"
+
+// requestIDFromContext returns the request ID stored in ctx, or "" if none.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(RequestIDKey).(string)
+	return id
+}
+
+// loggingMiddleware logs method name, input length, error, and elapsed time
+// for every call, tagged with the request ID carried on the context.
+type loggingMiddleware struct {
+	logger kitlog.Logger
+	next   StringService
+}
+
+// NewLoggingMiddleware returns a ServiceMiddleware that logs each call.
+func NewLoggingMiddleware(logger kitlog.Logger) ServiceMiddleware {
+	return func(next StringService) StringService {
+		return loggingMiddleware{logger, next}
+	}
+}
+
+func (mw loggingMiddleware) Uppercase(ctx context.Context, s string) (output string, err error) {
+	defer func(begin time.Time) {
+		mw.logger.Log(
+			"method", "uppercase",
+			"request_id", requestIDFromContext(ctx),
+			"input_len", len(s),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	output, err = mw.next.Uppercase(ctx, s)
+	return
+}
+
+func (mw loggingMiddleware) Count(ctx context.Context, s string) (n int) {
+	defer func(begin time.Time) {
+		mw.logger.Log(
+			"method", "count",
+			"request_id", requestIDFromContext(ctx),
+			"input_len", len(s),
+			"err", nil,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	n = mw.next.Count(ctx, s)
+	return
+}
+
+func (mw loggingMiddleware) TransformStream(ctx context.Context, ops []string, r io.Reader, w io.Writer) (err error) {
+	defer func(begin time.Time) {
+		mw.logger.Log(
+			"method", "transform_stream",
+			"request_id", requestIDFromContext(ctx),
+			"ops", strings.Join(ops, ","),
+			"err", err,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	err = mw.next.TransformStream(ctx, ops, r, w)
+	return
+}
+
+// instrumentingMiddleware records Prometheus counters for requests and a
+// histogram of request latency, both labeled by method and error.
+type instrumentingMiddleware struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	next           StringService
+}
+
+// NewInstrumentingMiddleware returns a ServiceMiddleware that records
+// request counts and latencies against the given metrics.
+func NewInstrumentingMiddleware(requestCount metrics.Counter, requestLatency metrics.Histogram) ServiceMiddleware {
+	return func(next StringService) StringService {
+		return instrumentingMiddleware{requestCount, requestLatency, next}
+	}
+}
+
+func (mw instrumentingMiddleware) Uppercase(ctx context.Context, s string) (output string, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "uppercase", "error", errLabel(err)}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	output, err = mw.next.Uppercase(ctx, s)
+	return
+}
+
+func (mw instrumentingMiddleware) Count(ctx context.Context, s string) (n int) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "count", "error", "false"}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	n = mw.next.Count(ctx, s)
+	return
+}
+
+func (mw instrumentingMiddleware) TransformStream(ctx context.Context, ops []string, r io.Reader, w io.Writer) (err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "transform_stream", "error", errLabel(err)}
+		mw.requestCount.With(lvs...).Add(1)
+		mw.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+	err = mw.next.TransformStream(ctx, ops, r, w)
+	return
+}
+
+func errLabel(err error) string {
+	if err != nil {
+		return "true"
+	}
+	return "false"
+}
@@ -0,0 +1,49 @@
+package stringsvc
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Transform op names understood by TransformStream's pipeline.
+const (
+	OpUppercase    = "uppercase"
+	OpLowercase    = "lowercase"
+	OpReverse      = "reverse"
+	OpTrim         = "trim"
+	OpCountPerLine = "count-per-line"
+)
+
+// transformLine applies ops, in order, to line. Unknown ops are no-ops, so
+// a typo in a pipeline degrades gracefully rather than aborting the stream.
+func transformLine(ops []string, line string) string {
+	for _, op := range ops {
+		line = applyTransformOp(op, line)
+	}
+	return line
+}
+
+func applyTransformOp(op, line string) string {
+	switch op {
+	case OpUppercase:
+		return strings.ToUpper(line)
+	case OpLowercase:
+		return strings.ToLower(line)
+	case OpReverse:
+		return reverseString(line)
+	case OpTrim:
+		return strings.TrimSpace(line)
+	case OpCountPerLine:
+		return strconv.Itoa(len(line))
+	default:
+		return line
+	}
+}
+
+func reverseString(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
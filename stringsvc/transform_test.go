@@ -0,0 +1,25 @@
+package stringsvc
+
+import "testing"
+
+func TestTransformLine(t *testing.T) {
+	cases := []struct {
+		ops  []string
+		line string
+		want string
+	}{
+		{[]string{OpUppercase}, "hello", "HELLO"},
+		{[]string{OpLowercase}, "HELLO", "hello"},
+		{[]string{OpReverse}, "hello", "olleh"},
+		{[]string{OpTrim}, "  hello  ", "hello"},
+		{[]string{OpCountPerLine}, "hello", "5"},
+		{[]string{OpTrim, OpUppercase}, "  hello  ", "HELLO"},
+		{[]string{"unknown"}, "hello", "hello"},
+	}
+
+	for _, c := range cases {
+		if got := transformLine(c.ops, c.line); got != c.want {
+			t.Errorf("transformLine(%v, %q) = %q, want %q", c.ops, c.line, got, c.want)
+		}
+	}
+}
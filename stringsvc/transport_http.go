@@ -0,0 +1,90 @@
+package stringsvc
+
+// ============ TRANSPORTS ============
+// >>> Expose service to the outside world! Go kit supports many TRANSPORTS
+// out-of-the-box. This file wires the Set up behind JSON-over-HTTP, using
+// the helper struct in package 'transport/http'.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	httpTransport "github.com/go-kit/kit/transport/http"
+)
+
+// NewHTTPHandler mounts the Set's endpoints behind /uppercase and /count.
+func NewHTTPHandler(endpoints Set) http.Handler {
+	opts := []httpTransport.ServerOption{
+		httpTransport.ServerBefore(ExtractRequestID),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/uppercase", httpTransport.NewServer(
+		endpoints.UppercaseEndpoint,
+		decodeUppercaseRequest,
+		encodeResponse,
+		opts...,
+	))
+	mux.Handle("/count", httpTransport.NewServer(
+		endpoints.CountEndpoint,
+		decodeCountRequest,
+		encodeResponse,
+		opts...,
+	))
+	return mux
+}
+
+// ExtractRequestID is a go-kit transport/http RequestFunc that copies the
+// X-Request-ID header onto the request context so downstream logging and
+// metrics can be correlated with the inbound call.
+func ExtractRequestID(ctx context.Context, r *http.Request) context.Context {
+	if id := r.Header.Get("X-Request-ID"); id != "" {
+		ctx = context.WithValue(ctx, RequestIDKey, id)
+	}
+	return ctx
+}
+
+func decodeUppercaseRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request UppercaseRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func decodeCountRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request CountRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	return json.NewEncoder(w).Encode(response)
+}
+
+// encodeUppercaseRequest is a go-kit transport/http client-side
+// EncodeRequestFunc used by the proxying middleware to call a peer's
+// /uppercase handler.
+func encodeUppercaseRequest(_ context.Context, r *http.Request, request interface{}) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(request); err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(&buf)
+	return nil
+}
+
+// decodeUppercaseResponse is a go-kit transport/http client-side
+// DecodeResponseFunc for the same peer call.
+func decodeUppercaseResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	var response UppercaseResponse
+	if err := json.NewDecoder(r.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
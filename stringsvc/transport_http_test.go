@@ -0,0 +1,33 @@
+package stringsvc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestHTTPHandlerSurfacesRateLimitRejection guards against the same
+// regression as TestRateLimitErrorPropagatesThroughBreaker, but through
+// the actual HTTP transport: a rejected request must come back as an
+// error response, not a 200 with a "null" body.
+func TestHTTPHandlerSurfacesRateLimitRejection(t *testing.T) {
+	cfg := ResilienceConfig{
+		QPS:            0,
+		Burst:          0,
+		BreakerTimeout: time.Minute,
+		FailureRatio:   0.5,
+	}
+	endpoints := NewSet(stringService{}, cfg)
+	handler := NewHTTPHandler(endpoints)
+
+	req := httptest.NewRequest(http.MethodPost, "/uppercase", strings.NewReader(`{"s":"x"}`))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req.WithContext(context.Background()))
+
+	if rec.Code == http.StatusOK {
+		t.Fatalf("expected a non-200 status for a rate-limited request, got %d with body %q", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,108 @@
+package stringsvc
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+var transformUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+}
+
+// NewTransformHTTPHandler exposes svc.TransformStream over chunked,
+// text/plain HTTP: each input line is transformed and flushed back as
+// soon as it's ready, without waiting for the rest of the input. The
+// pipeline is given as a comma-separated ?ops= query parameter, e.g.
+// /transform?ops=trim,uppercase. Calling through svc means /transform
+// traffic gets the same logging, metrics, and resilience as every other
+// method.
+func NewTransformHTTPHandler(svc StringService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ops := parseOps(r.URL.Query().Get("ops"))
+		w.Header().Set("Content-Type", "text/plain")
+
+		if err := svc.TransformStream(r.Context(), ops, r.Body, flushWriter{w}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// NewTransformWSHandler exposes svc.TransformStream over a WebSocket: each
+// inbound text frame is one input line, and each outbound text frame is
+// its transformed result. The pipeline is given the same way as the HTTP
+// handler, via ?ops=.
+func NewTransformWSHandler(svc StringService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ops := parseOps(r.URL.Query().Get("ops"))
+
+		conn, err := transformUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		buf := []byte{}
+		svc.TransformStream(r.Context(), ops, wsReader{conn, &buf}, wsWriter{conn})
+	})
+}
+
+func parseOps(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, ",")
+}
+
+// flushWriter flushes after every Write, if the underlying writer supports
+// it, so chunked-transfer HTTP clients see each transformed line as soon
+// as it's written rather than all at once at the end.
+type flushWriter struct {
+	w io.Writer
+}
+
+func (fw flushWriter) Write(p []byte) (int, error) {
+	n, err := fw.w.Write(p)
+	if flusher, ok := fw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
+
+// wsReader adapts a WebSocket connection to an io.Reader, treating each
+// inbound text frame as one line of input terminated by "\n" so it can be
+// scanned by TransformStream the same way a chunked-HTTP body is. buf
+// holds whatever the caller's slice was too small to take in one Read.
+type wsReader struct {
+	conn *websocket.Conn
+	buf  *[]byte
+}
+
+func (r wsReader) Read(p []byte) (int, error) {
+	if len(*r.buf) == 0 {
+		_, data, err := r.conn.ReadMessage()
+		if err != nil {
+			return 0, io.EOF
+		}
+		*r.buf = append(data, '\n')
+	}
+	n := copy(p, *r.buf)
+	*r.buf = (*r.buf)[n:]
+	return n, nil
+}
+
+// wsWriter adapts a WebSocket connection to an io.Writer, sending each
+// line TransformStream writes as its own outbound text frame.
+type wsWriter struct {
+	conn *websocket.Conn
+}
+
+func (w wsWriter) Write(p []byte) (int, error) {
+	if err := w.conn.WriteMessage(websocket.TextMessage, []byte(strings.TrimSuffix(string(p), "\n"))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,74 @@
+// Package grpc wires a stringsvc.Set up behind a gRPC server, reusing the
+// same endpoints the HTTP transport uses.
+package grpc
+
+import (
+	"context"
+
+	grpckit "github.com/go-kit/kit/transport/grpc"
+
+	"github.com/jaggedprospect/gokit-stringsvc/pb"
+	"github.com/jaggedprospect/gokit-stringsvc/stringsvc"
+)
+
+// grpcServer implements pb.StringServiceServer on top of a stringsvc.Set,
+// converting protobuf messages to and from the endpoints' request/response
+// structs.
+type grpcServer struct {
+	uppercase grpckit.Handler
+	count     grpckit.Handler
+
+	pb.UnimplementedStringServiceServer
+}
+
+// NewGRPCServer returns a pb.StringServiceServer backed by the given Set.
+func NewGRPCServer(endpoints stringsvc.Set) pb.StringServiceServer {
+	return &grpcServer{
+		uppercase: grpckit.NewServer(
+			endpoints.UppercaseEndpoint,
+			decodeUppercaseRequest,
+			encodeUppercaseResponse,
+		),
+		count: grpckit.NewServer(
+			endpoints.CountEndpoint,
+			decodeCountRequest,
+			encodeCountResponse,
+		),
+	}
+}
+
+func (s *grpcServer) Uppercase(ctx context.Context, req *pb.UppercaseRequest) (*pb.UppercaseReply, error) {
+	_, resp, err := s.uppercase.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.UppercaseReply), nil
+}
+
+func (s *grpcServer) Count(ctx context.Context, req *pb.CountRequest) (*pb.CountReply, error) {
+	_, resp, err := s.count.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.CountReply), nil
+}
+
+func decodeUppercaseRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UppercaseRequest)
+	return stringsvc.UppercaseRequest{S: req.S}, nil
+}
+
+func encodeUppercaseResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(stringsvc.UppercaseResponse)
+	return &pb.UppercaseReply{V: resp.V, Err: resp.Err}, nil
+}
+
+func decodeCountRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CountRequest)
+	return stringsvc.CountRequest{S: req.S}, nil
+}
+
+func encodeCountResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(stringsvc.CountResponse)
+	return &pb.CountReply{V: int64(resp.V)}, nil
+}
@@ -0,0 +1,51 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/jaggedprospect/gokit-stringsvc/pb"
+	"github.com/jaggedprospect/gokit-stringsvc/stringsvc"
+	grpcTransport "github.com/jaggedprospect/gokit-stringsvc/transport/grpc"
+)
+
+// TestGRPCServerSurfacesRateLimitRejection guards against the same
+// regression as stringsvc.TestRateLimitErrorPropagatesThroughBreaker, but
+// through the real gRPC transport: a rejected request must come back as a
+// gRPC error, not panic the server trying to type-assert a nil response.
+func TestGRPCServerSurfacesRateLimitRejection(t *testing.T) {
+	cfg := stringsvc.ResilienceConfig{
+		QPS:            0,
+		Burst:          0,
+		BreakerTimeout: time.Minute,
+		FailureRatio:   0.5,
+	}
+	endpoints := stringsvc.NewSet(stringsvc.New(), cfg)
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pb.RegisterStringServiceServer(server, grpcTransport.NewGRPCServer(endpoints))
+	go server.Serve(lis)
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewStringServiceClient(conn)
+	if _, err := client.Uppercase(context.Background(), &pb.UppercaseRequest{S: "x"}); err == nil {
+		t.Fatal("expected the rate limiter's rejection to come back as a gRPC error")
+	}
+}